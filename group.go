@@ -0,0 +1,70 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+)
+
+// GroupService handles groups for the JIRA instance / API.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/group
+type GroupService struct {
+	client *Client
+}
+
+// AddUserWithContext adds the user identified by accountID to the group
+// named groupname.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/group-addUserToGroup
+func (s *GroupService) AddUserWithContext(ctx context.Context, groupname, accountID string) (*Response, error) {
+	qb := newQueryBuilder()
+	qb.Add("groupname", groupname)
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?%s", qb.Encode())
+	payload := struct {
+		AccountID string `json:"accountId"`
+	}{AccountID: accountID}
+
+	req, err := s.client.NewRequestWithContext(ctx, "POST", apiEndpoint, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// AddUser wraps AddUserWithContext using the background context.
+func (s *GroupService) AddUser(groupname, accountID string) (*Response, error) {
+	return s.AddUserWithContext(context.Background(), groupname, accountID)
+}
+
+// RemoveUserWithContext removes the user identified by accountID from the
+// group named groupname.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/group-removeUserFromGroup
+func (s *GroupService) RemoveUserWithContext(ctx context.Context, groupname, accountID string) (*Response, error) {
+	qb := newQueryBuilder()
+	qb.Add("groupname", groupname)
+	qb.Add("accountId", accountID)
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/group/user?%s", qb.Encode())
+	req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := s.client.Do(req, nil)
+	if err != nil {
+		return resp, NewJiraError(resp, err)
+	}
+	return resp, nil
+}
+
+// RemoveUser wraps RemoveUserWithContext using the background context.
+func (s *GroupService) RemoveUser(groupname, accountID string) (*Response, error) {
+	return s.RemoveUserWithContext(context.Background(), groupname, accountID)
+}
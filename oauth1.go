@@ -0,0 +1,287 @@
+package jira
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"encoding/base64"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// OAuth1Config holds the consumer / token details needed to sign requests
+// against a JIRA Server / Data Center instance using OAuth 1.0a with an
+// RSA-SHA1 signature, as required by the Atlassian Application Link
+// "Incoming Authentication" flow.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/oauth/
+type OAuth1Config struct {
+	// ConsumerKey is the consumer key configured on the Application Link.
+	ConsumerKey string
+	// PrivateKey is the RSA private key whose public counterpart was
+	// registered with the Application Link.
+	PrivateKey *rsa.PrivateKey
+	// AccessToken is the token obtained from RequestAccessToken. It may be
+	// left empty while performing the three-legged handshake.
+	AccessToken string
+}
+
+// OAuth1Transport is an http.RoundTripper that signs every outbound request
+// using OAuth 1.0a with the RSA-SHA1 signature method.
+type OAuth1Transport struct {
+	Config *OAuth1Config
+
+	// Transport is the underlying http.RoundTripper used to make requests.
+	// If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+}
+
+// Client returns an *http.Client that signs its requests using t.
+func (t *OAuth1Transport) Client() *http.Client {
+	return &http.Client{Transport: t}
+}
+
+func (t *OAuth1Transport) transport() http.RoundTripper {
+	if t.Transport != nil {
+		return t.Transport
+	}
+	return http.DefaultTransport
+}
+
+// RoundTrip implements http.RoundTripper, signing the request with the
+// OAuth 1.0a / RSA-SHA1 scheme before delegating to the underlying
+// transport.
+func (t *OAuth1Transport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req2 := cloneRequest(req)
+
+	params := map[string]string{
+		"oauth_consumer_key":     t.Config.ConsumerKey,
+		"oauth_nonce":            nonce(),
+		"oauth_signature_method": "RSA-SHA1",
+		"oauth_timestamp":        strconv.FormatInt(time.Now().Unix(), 10),
+		"oauth_version":          "1.0",
+	}
+	if t.Config.AccessToken != "" {
+		params["oauth_token"] = t.Config.AccessToken
+	}
+
+	signature, err := t.sign(req2, params)
+	if err != nil {
+		return nil, fmt.Errorf("oauth1: could not sign request: %w", err)
+	}
+	params["oauth_signature"] = signature
+
+	req2.Header.Set("Authorization", authorizationHeader(params))
+
+	return t.transport().RoundTrip(req2)
+}
+
+func (t *OAuth1Transport) sign(req *http.Request, oauthParams map[string]string) (string, error) {
+	base := signatureBaseString(req, oauthParams)
+
+	h := sha1.New()
+	h.Write([]byte(base))
+	digest := h.Sum(nil)
+
+	sig, err := rsa.SignPKCS1v15(rand.Reader, t.Config.PrivateKey, crypto.SHA1, digest)
+	if err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(sig), nil
+}
+
+// signatureBaseString builds the OAuth 1.0a signature base string: the
+// uppercased HTTP method, the normalized request URL and the sorted,
+// percent-encoded query + oauth parameters, each percent-encoded in turn
+// and joined with "&".
+func signatureBaseString(req *http.Request, oauthParams map[string]string) string {
+	normalizedURL := &url.URL{
+		Scheme: req.URL.Scheme,
+		Host:   req.URL.Host,
+		Path:   req.URL.Path,
+	}
+
+	values := url.Values{}
+	for key, vals := range req.URL.Query() {
+		for _, v := range vals {
+			values.Add(key, v)
+		}
+	}
+	for k, v := range oauthParams {
+		values.Set(k, v)
+	}
+
+	keys := make([]string, 0, len(values))
+	for k := range values {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		for _, v := range values[k] {
+			pairs = append(pairs, percentEncode(k)+"="+percentEncode(v))
+		}
+	}
+	paramString := strings.Join(pairs, "&")
+
+	return strings.Join([]string{
+		strings.ToUpper(req.Method),
+		percentEncode(normalizedURL.String()),
+		percentEncode(paramString),
+	}, "&")
+}
+
+func authorizationHeader(params map[string]string) string {
+	keys := make([]string, 0, len(params))
+	for k := range params {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, fmt.Sprintf(`%s="%s"`, percentEncode(k), percentEncode(params[k])))
+	}
+	return "OAuth " + strings.Join(pairs, ", ")
+}
+
+// percentEncode percent-encodes s per RFC 3986, as required by the OAuth
+// 1.0a signature spec (url.QueryEscape encodes spaces as "+", which OAuth
+// does not allow).
+func percentEncode(s string) string {
+	escaped := url.QueryEscape(s)
+	escaped = strings.ReplaceAll(escaped, "+", "%20")
+	return escaped
+}
+
+func nonce() string {
+	n, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+	if err != nil {
+		return strconv.FormatInt(time.Now().UnixNano(), 10)
+	}
+	return strconv.FormatInt(n.Int64(), 10)
+}
+
+func cloneRequest(req *http.Request) *http.Request {
+	req2 := new(http.Request)
+	*req2 = *req
+	req2.Header = make(http.Header, len(req.Header))
+	for k, v := range req.Header {
+		req2.Header[k] = append([]string(nil), v...)
+	}
+	return req2
+}
+
+// RequestTemporaryToken performs the first leg of the OAuth 1.0a handshake
+// against baseURL+"/plugins/servlet/oauth/request-token", returning the
+// unauthorized request token. Any token secret in the response is not
+// returned.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/oauth/#step-1--obtaining-a-request-token
+func RequestTemporaryToken(config *OAuth1Config, baseURL, callbackURL string) (token string, err error) {
+	transport := &OAuth1Transport{Config: config}
+	client := transport.Client()
+
+	endpoint := strings.TrimSuffix(baseURL, "/") + "/plugins/servlet/oauth/request-token"
+	req, err := http.NewRequest("POST", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	q := req.URL.Query()
+	if callbackURL != "" {
+		q.Set("oauth_callback", callbackURL)
+	}
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("oauth1: request-token returned %s: %s", resp.Status, string(body))
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return "", err
+	}
+	token = values.Get("oauth_token")
+	if token == "" {
+		return "", fmt.Errorf("oauth1: request-token response did not include oauth_token")
+	}
+	return token, nil
+}
+
+// AuthorizationURL builds the URL the resource owner must visit to
+// authorize the temporary token obtained from RequestTemporaryToken. Once
+// authorized, Jira redirects to the oauth_callback passed to
+// RequestTemporaryToken with an oauth_verifier query parameter attached
+// (or, for callback="oob", displays the verifier for the user to copy);
+// that value must be passed to RequestAccessToken.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/oauth/#step-2--authorizing-the-request-token
+func AuthorizationURL(baseURL, temporaryToken string) string {
+	u := strings.TrimSuffix(baseURL, "/") + "/plugins/servlet/oauth/authorize"
+	return fmt.Sprintf("%s?oauth_token=%s", u, url.QueryEscape(temporaryToken))
+}
+
+// RequestAccessToken performs the final leg of the OAuth 1.0a handshake
+// against baseURL+"/plugins/servlet/oauth/access-token", exchanging the
+// authorized temporary token and its oauth_verifier (obtained from the
+// authorize callback, see AuthorizationURL) for a long-lived access token.
+//
+// JIRA API docs: https://developer.atlassian.com/server/jira/platform/oauth/#step-3--exchanging-request-token-for-access-token
+func RequestAccessToken(config *OAuth1Config, baseURL, temporaryToken, verifier string) (accessToken string, err error) {
+	cfg := *config
+	cfg.AccessToken = temporaryToken
+	transport := &OAuth1Transport{Config: &cfg}
+	client := transport.Client()
+
+	endpoint := strings.TrimSuffix(baseURL, "/") + "/plugins/servlet/oauth/access-token"
+	req, err := http.NewRequest("POST", endpoint, nil)
+	if err != nil {
+		return "", err
+	}
+	q := req.URL.Query()
+	q.Set("oauth_verifier", verifier)
+	req.URL.RawQuery = q.Encode()
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return "", err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("oauth1: access-token returned %s: %s", resp.Status, string(body))
+	}
+
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return "", err
+	}
+	accessToken = values.Get("oauth_token")
+	if accessToken == "" {
+		return "", fmt.Errorf("oauth1: access-token response did not include oauth_token")
+	}
+	return accessToken, nil
+}
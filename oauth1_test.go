@@ -0,0 +1,60 @@
+package jira
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+)
+
+// TestSignatureBaseString checks signatureBaseString against the worked
+// example from Twitter's OAuth 1.0a documentation (the same test vector
+// most OAuth 1.0a libraries use), which exercises sorting, percent-encoding
+// and the "encode twice" rule for the parameter string.
+//
+// https://developer.twitter.com/en/docs/authentication/oauth-1-0a/creating-a-signature
+func TestSignatureBaseString(t *testing.T) {
+	values := url.Values{}
+	values.Set("status", "Hello Ladies + Gentlemen, a signed OAuth request!")
+	values.Set("include_entities", "true")
+
+	req, err := http.NewRequest("POST", "https://api.twitter.com/1/statuses/update.json", nil)
+	if err != nil {
+		t.Fatalf("http.NewRequest: %v", err)
+	}
+	req.URL.RawQuery = values.Encode()
+
+	oauthParams := map[string]string{
+		"oauth_consumer_key":     "xvz1evFS4wEEPTGEFPHBog",
+		"oauth_nonce":            "kYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg",
+		"oauth_signature_method": "HMAC-SHA1",
+		"oauth_timestamp":        "1318622958",
+		"oauth_token":            "370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb",
+		"oauth_version":          "1.0",
+	}
+
+	want := "POST&https%3A%2F%2Fapi.twitter.com%2F1%2Fstatuses%2Fupdate.json&" +
+		"include_entities%3Dtrue%26oauth_consumer_key%3Dxvz1evFS4wEEPTGEFPHBog%26" +
+		"oauth_nonce%3DkYjzVBB8Y0ZFabxSWbWovY3uYSQ2pTgmZeNu2VS4cg%26" +
+		"oauth_signature_method%3DHMAC-SHA1%26oauth_timestamp%3D1318622958%26" +
+		"oauth_token%3D370773112-GmHxMAgYyLbNEtIKZeRNFsMKPR9EyMZeS9weJAEb%26" +
+		"oauth_version%3D1.0%26status%3DHello%2520Ladies%2520%252B%2520Gentlemen%252C" +
+		"%2520a%2520signed%2520OAuth%2520request%2521"
+
+	if got := signatureBaseString(req, oauthParams); got != want {
+		t.Errorf("signatureBaseString() =\n%q\nwant\n%q", got, want)
+	}
+}
+
+func TestPercentEncode(t *testing.T) {
+	tests := map[string]string{
+		"Ladies + Gentlemen": "Ladies%20%2B%20Gentlemen",
+		"An encoded string!": "An%20encoded%20string%21",
+		"Dogs, Cats & Mice":  "Dogs%2C%20Cats%20%26%20Mice",
+		"☃":                  "%E2%98%83",
+	}
+	for in, want := range tests {
+		if got := percentEncode(in); got != want {
+			t.Errorf("percentEncode(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
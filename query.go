@@ -0,0 +1,27 @@
+package jira
+
+import "net/url"
+
+// queryBuilder accumulates query parameters for search endpoints. It is
+// backed by url.Values so that keys can repeat and every key/value is
+// percent-encoded correctly, unlike the ad-hoc string concatenation the
+// search endpoints used to do.
+type queryBuilder struct {
+	values url.Values
+}
+
+// newQueryBuilder returns an empty queryBuilder.
+func newQueryBuilder() *queryBuilder {
+	return &queryBuilder{values: url.Values{}}
+}
+
+// Add appends value under key, preserving any values already set for that
+// key.
+func (qb *queryBuilder) Add(key, value string) {
+	qb.values.Add(key, value)
+}
+
+// Encode returns the URL-encoded query string, e.g. "a=1&b=2".
+func (qb *queryBuilder) Encode() string {
+	return qb.values.Encode()
+}
@@ -0,0 +1,63 @@
+package jira
+
+import "testing"
+
+func TestQueryBuilderEncode(t *testing.T) {
+	tests := []struct {
+		name string
+		add  func(qb *queryBuilder)
+		want string
+	}{
+		{
+			name: "plus and spaces",
+			add: func(qb *queryBuilder) {
+				qb.Add("username", "john+doe test")
+			},
+			want: "username=john%2Bdoe+test",
+		},
+		{
+			name: "unicode display name",
+			add: func(qb *queryBuilder) {
+				qb.Add("username", "Jörg Müller")
+			},
+			want: "username=J%C3%B6rg+M%C3%BCller",
+		},
+		{
+			name: "multi-valued keys",
+			add: func(qb *queryBuilder) {
+				qb.Add("projectKeys", "ABC")
+				qb.Add("projectKeys", "DEF")
+			},
+			want: "projectKeys=ABC&projectKeys=DEF",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			qb := newQueryBuilder()
+			tt.add(qb)
+			if got := qb.Encode(); got != tt.want {
+				t.Errorf("Encode() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSearchOptionsWriteIntoQueryBuilder(t *testing.T) {
+	qb := newQueryBuilder()
+	tweaks := []SearchOption{
+		WithMaxResults(50),
+		WithStartAt(100),
+		WithActive(true),
+		WithSearchParam(SearchParam{Name: "username", Value: "a@b.com"}),
+	}
+	for _, f := range tweaks {
+		f(qb)
+	}
+
+	got := qb.Encode()
+	want := "includeActive=true&maxResults=50&startAt=100&username=a%40b.com"
+	if got != want {
+		t.Errorf("Encode() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,68 @@
+package jira
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// retryableRequest is implemented by the handful of Response-returning
+// calls withRetry knows how to retry.
+type retryableRequest func() (*Response, error)
+
+// withRetry runs fn, retrying with exponential backoff when the response
+// is a 429 or 5xx, up to maxRetries times. A Retry-After header on the
+// response, if present, takes precedence over the computed backoff.
+func withRetry(ctx context.Context, maxRetries int, fn retryableRequest) (*Response, error) {
+	var resp *Response
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		resp, err = fn()
+		if !shouldRetry(resp) || attempt >= maxRetries {
+			return resp, err
+		}
+
+		wait := retryAfter(resp)
+		if wait <= 0 {
+			wait = backoff(attempt)
+		}
+
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return resp, ctx.Err()
+		}
+	}
+}
+
+func shouldRetry(resp *Response) bool {
+	if resp == nil || resp.Response == nil {
+		return false
+	}
+	return resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= http.StatusInternalServerError
+}
+
+func retryAfter(resp *Response) time.Duration {
+	if resp == nil || resp.Response == nil {
+		return 0
+	}
+	raw := resp.Header.Get("Retry-After")
+	if raw == "" {
+		return 0
+	}
+	if seconds, err := strconv.Atoi(raw); err == nil {
+		return time.Duration(seconds) * time.Second
+	}
+	if when, err := http.ParseTime(raw); err == nil {
+		return time.Until(when)
+	}
+	return 0
+}
+
+// backoff returns the base exponential delay for the given (zero-indexed)
+// attempt: 1s, 2s, 4s, 8s, ...
+func backoff(attempt int) time.Duration {
+	return time.Duration(1<<uint(attempt)) * time.Second
+}
@@ -39,14 +39,26 @@ type UserGroup struct {
 	Name string `json:"name,omitempty" structs:"name,omitempty"`
 }
 
-type userSearchParam struct {
-	name  string
-	value string
+// SearchParam is a single query parameter contributed to a user search by
+// a SearchOption, such as WithMaxResults or WithProject.
+type SearchParam struct {
+	Name  string
+	Value string
 }
 
-type userSearch []userSearchParam
-
-type userSearchF func(userSearch) userSearch
+// SearchOption adjusts the query parameters sent to a user search
+// endpoint. Use WithSearchParam to compose custom options outside this
+// package.
+type SearchOption func(*queryBuilder)
+
+// WithSearchParam returns a SearchOption that adds an arbitrary parameter,
+// letting callers outside this package compose options FindWithContext and
+// friends don't already provide.
+func WithSearchParam(param SearchParam) SearchOption {
+	return func(qb *queryBuilder) {
+		qb.Add(param.Name, param.Value)
+	}
+}
 
 // GetWithContext gets user info from JIRA
 //
@@ -133,6 +145,31 @@ func (s *UserService) Create(user *User) (*User, *Response, error) {
 	return s.CreateWithContext(context.Background(), user)
 }
 
+// UpdateWithContext updates an existing user in JIRA, identified by
+// user.AccountID. It is primarily used to flip User.Active, since Jira has
+// no dedicated enable/disable endpoint.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-updateUser
+func (s *UserService) UpdateWithContext(ctx context.Context, user *User) (*User, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user?accountId=%s", user.AccountID)
+	req, err := s.client.NewRequestWithContext(ctx, "PUT", apiEndpoint, user)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	responseUser := new(User)
+	resp, err := s.client.Do(req, responseUser)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return responseUser, resp, nil
+}
+
+// Update wraps UpdateWithContext using the background context.
+func (s *UserService) Update(user *User) (*User, *Response, error) {
+	return s.UpdateWithContext(context.Background(), user)
+}
+
 // DeleteWithContext deletes an user from JIRA.
 // Returns http.StatusNoContent on success.
 //
@@ -179,6 +216,31 @@ func (s *UserService) GetGroups(username string) (*[]UserGroup, *Response, error
 	return s.GetGroupsWithContext(context.Background(), username)
 }
 
+// GetGroupsByAccountIDWithContext returns the groups which the user
+// identified by accountID belongs to.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-getUserGroups
+func (s *UserService) GetGroupsByAccountIDWithContext(ctx context.Context, accountID string) (*[]UserGroup, *Response, error) {
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user/groups?accountId=%s", accountID)
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	userGroups := new([]UserGroup)
+	resp, err := s.client.Do(req, userGroups)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return userGroups, resp, nil
+}
+
+// GetGroupsByAccountID wraps GetGroupsByAccountIDWithContext using the
+// background context.
+func (s *UserService) GetGroupsByAccountID(accountID string) (*[]UserGroup, *Response, error) {
+	return s.GetGroupsByAccountIDWithContext(context.Background(), accountID)
+}
+
 // GetSelfWithContext information about the current logged-in user
 //
 // JIRA API docs: https://developer.atlassian.com/cloud/jira/platform/rest/#api-api-2-myself-get
@@ -202,34 +264,30 @@ func (s *UserService) GetSelf() (*User, *Response, error) {
 }
 
 // WithMaxResults sets the max results to return
-func WithMaxResults(maxResults int) userSearchF {
-	return func(s userSearch) userSearch {
-		s = append(s, userSearchParam{name: "maxResults", value: fmt.Sprintf("%d", maxResults)})
-		return s
+func WithMaxResults(maxResults int) SearchOption {
+	return func(qb *queryBuilder) {
+		qb.Add("maxResults", fmt.Sprintf("%d", maxResults))
 	}
 }
 
 // WithStartAt set the start pager
-func WithStartAt(startAt int) userSearchF {
-	return func(s userSearch) userSearch {
-		s = append(s, userSearchParam{name: "startAt", value: fmt.Sprintf("%d", startAt)})
-		return s
+func WithStartAt(startAt int) SearchOption {
+	return func(qb *queryBuilder) {
+		qb.Add("startAt", fmt.Sprintf("%d", startAt))
 	}
 }
 
 // WithActive sets the active users lookup
-func WithActive(active bool) userSearchF {
-	return func(s userSearch) userSearch {
-		s = append(s, userSearchParam{name: "includeActive", value: fmt.Sprintf("%t", active)})
-		return s
+func WithActive(active bool) SearchOption {
+	return func(qb *queryBuilder) {
+		qb.Add("includeActive", fmt.Sprintf("%t", active))
 	}
 }
 
 // WithInactive sets the inactive users lookup
-func WithInactive(inactive bool) userSearchF {
-	return func(s userSearch) userSearch {
-		s = append(s, userSearchParam{name: "includeInactive", value: fmt.Sprintf("%t", inactive)})
-		return s
+func WithInactive(inactive bool) SearchOption {
+	return func(qb *queryBuilder) {
+		qb.Add("includeInactive", fmt.Sprintf("%t", inactive))
 	}
 }
 
@@ -237,23 +295,14 @@ func WithInactive(inactive bool) userSearchF {
 // It can find users by email, username or name
 //
 // JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-findUsers
-func (s *UserService) FindWithContext(ctx context.Context, property string, tweaks ...userSearchF) ([]User, *Response, error) {
-	search := []userSearchParam{
-		{
-			name:  "username",
-			value: property,
-		},
-	}
+func (s *UserService) FindWithContext(ctx context.Context, property string, tweaks ...SearchOption) ([]User, *Response, error) {
+	qb := newQueryBuilder()
+	qb.Add("username", property)
 	for _, f := range tweaks {
-		search = f(search)
-	}
-
-	var queryString = ""
-	for _, param := range search {
-		queryString += param.name + "=" + param.value + "&"
+		f(qb)
 	}
 
-	apiEndpoint := fmt.Sprintf("/rest/api/2/user/search?%s", queryString[:len(queryString)-1])
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user/search?%s", qb.Encode())
 	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
 	if err != nil {
 		return nil, nil, err
@@ -268,6 +317,6 @@ func (s *UserService) FindWithContext(ctx context.Context, property string, twea
 }
 
 // Find wraps FindWithContext using the background context.
-func (s *UserService) Find(property string, tweaks ...userSearchF) ([]User, *Response, error) {
+func (s *UserService) Find(property string, tweaks ...SearchOption) ([]User, *Response, error) {
 	return s.FindWithContext(context.Background(), property, tweaks...)
 }
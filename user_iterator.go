@@ -0,0 +1,200 @@
+package jira
+
+import "context"
+
+// defaultUserPageSize is the page size UserIterator requests for every page;
+// FindAll always sends it as maxResults.
+const defaultUserPageSize = 50
+
+// UserIterator walks all pages of a UserService.Find query. A background
+// goroutine fetches pages one at a time, ahead of the caller, into a
+// buffered channel.
+type UserIterator struct {
+	ctx      context.Context
+	service  *UserService
+	property string
+	tweaks   []SearchOption
+	prefetch int
+
+	startAt  int
+	pageSize int
+
+	pages chan pageResult
+	done  chan struct{}
+
+	current []User
+	index   int
+	user    User
+	err     error
+	closed  bool
+}
+
+type pageResult struct {
+	users []User
+	err   error
+}
+
+// userIteratorOption configures a UserIterator.
+type userIteratorOption func(*UserIterator)
+
+// WithPrefetch makes the iterator keep up to n pages buffered ahead of the
+// caller (the default is one). Pages are still fetched one at a time.
+func WithPrefetch(n int) userIteratorOption {
+	return func(it *UserIterator) {
+		if n > 0 {
+			it.prefetch = n
+		}
+	}
+}
+
+// WithSearchOption adds SearchOptions (e.g. WithActive, WithInactive,
+// WithSearchParam) to every page request the iterator makes, the same way
+// tweaks passed to FindWithContext do.
+func WithSearchOption(tweaks ...SearchOption) userIteratorOption {
+	return func(it *UserIterator) {
+		it.tweaks = append(it.tweaks, tweaks...)
+	}
+}
+
+// FindAll returns a UserIterator that transparently walks every page of
+// property. SearchOptions passed via WithSearchOption are applied to every
+// page request the same way FindWithContext applies its tweaks. Pagination
+// stops as soon as Jira returns fewer than pageSize results.
+func (s *UserService) FindAll(ctx context.Context, property string, opts ...userIteratorOption) *UserIterator {
+	it := &UserIterator{
+		ctx:      ctx,
+		service:  s,
+		property: property,
+		pageSize: defaultUserPageSize,
+		prefetch: 1,
+	}
+	for _, opt := range opts {
+		opt(it)
+	}
+
+	it.tweaks = append([]SearchOption{WithMaxResults(it.pageSize)}, it.tweaks...)
+	it.pages = make(chan pageResult, it.prefetch)
+	it.done = make(chan struct{})
+	go it.fetchLoop()
+	return it
+}
+
+// FindAllChan returns a channel of users that is closed once every page has
+// been delivered or the context is cancelled. Errors are not surfaced on
+// the channel; call FindAll directly if you need to observe them.
+func (s *UserService) FindAllChan(ctx context.Context, property string, opts ...userIteratorOption) <-chan User {
+	out := make(chan User)
+	it := s.FindAll(ctx, property, opts...)
+	go func() {
+		defer close(out)
+		defer it.Close()
+		for it.Next() {
+			select {
+			case out <- it.User():
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+	return out
+}
+
+func (it *UserIterator) fetchLoop() {
+	startAt := 0
+	for {
+		select {
+		case <-it.done:
+			close(it.pages)
+			return
+		case <-it.ctx.Done():
+			select {
+			case it.pages <- pageResult{err: it.ctx.Err()}:
+			case <-it.done:
+			}
+			close(it.pages)
+			return
+		default:
+		}
+
+		tweaks := append(append([]SearchOption{}, it.tweaks...), WithStartAt(startAt))
+		users, _, err := it.service.FindWithContext(it.ctx, it.property, tweaks...)
+		if err != nil {
+			select {
+			case it.pages <- pageResult{err: err}:
+			case <-it.done:
+			}
+			close(it.pages)
+			return
+		}
+
+		select {
+		case it.pages <- pageResult{users: users}:
+		case <-it.done:
+			close(it.pages)
+			return
+		}
+
+		if len(users) < it.pageSize {
+			close(it.pages)
+			return
+		}
+		startAt += it.pageSize
+	}
+}
+
+// Next advances the iterator to the next user, fetching additional pages
+// as needed. It returns false once every page has been consumed, the
+// context is cancelled, or an error occurred; call Err to distinguish the
+// two.
+func (it *UserIterator) Next() bool {
+	if it.closed || it.err != nil {
+		return false
+	}
+
+	for it.index >= len(it.current) {
+		select {
+		case page, ok := <-it.pages:
+			if !ok {
+				return false
+			}
+			if page.err != nil {
+				it.err = page.err
+				return false
+			}
+			it.current = page.users
+			it.index = 0
+			if len(it.current) == 0 {
+				return false
+			}
+		case <-it.ctx.Done():
+			it.err = it.ctx.Err()
+			return false
+		}
+	}
+
+	it.user = it.current[it.index]
+	it.index++
+	return true
+}
+
+// User returns the user at the iterator's current position. Only valid
+// after a call to Next that returned true.
+func (it *UserIterator) User() User {
+	return it.user
+}
+
+// Err returns the first error encountered while paging, if any.
+func (it *UserIterator) Err() error {
+	return it.err
+}
+
+// Close stops the iterator's background page fetches. It is safe to call
+// Close multiple times, and safe to call even after Next has returned
+// false.
+func (it *UserIterator) Close() {
+	if it.closed {
+		return
+	}
+	it.closed = true
+	close(it.done)
+}
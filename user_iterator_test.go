@@ -0,0 +1,116 @@
+package jira
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// newTestIterator builds a UserIterator around bare pages/done channels,
+// bypassing FindAll's fetchLoop (which needs a working *Client) so Next,
+// Err and Close can be exercised directly against fed-in page results.
+func newTestIterator(ctx context.Context) *UserIterator {
+	return &UserIterator{
+		ctx:   ctx,
+		pages: make(chan pageResult, 4),
+		done:  make(chan struct{}),
+	}
+}
+
+func TestUserIteratorNextWalksPagesInOrder(t *testing.T) {
+	it := newTestIterator(context.Background())
+	it.pages <- pageResult{users: []User{{AccountID: "a"}, {AccountID: "b"}}}
+	it.pages <- pageResult{users: []User{{AccountID: "c"}}}
+	close(it.pages)
+
+	var got []string
+	for it.Next() {
+		got = append(got, it.User().AccountID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("Err() = %v, want nil", err)
+	}
+	want := []string{"a", "b", "c"}
+	if len(got) != len(want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Fatalf("got %v, want %v", got, want)
+		}
+	}
+}
+
+func TestUserIteratorSurfacesPageError(t *testing.T) {
+	it := newTestIterator(context.Background())
+	wantErr := errors.New("boom")
+	it.pages <- pageResult{err: wantErr}
+
+	if it.Next() {
+		t.Fatal("Next() = true, want false after an error page")
+	}
+	if it.Err() != wantErr {
+		t.Fatalf("Err() = %v, want %v", it.Err(), wantErr)
+	}
+	if it.Next() {
+		t.Fatal("Next() = true after Err() was already set")
+	}
+}
+
+func TestUserIteratorCloseStopsIteration(t *testing.T) {
+	it := newTestIterator(context.Background())
+	it.pages <- pageResult{users: []User{{AccountID: "a"}}}
+
+	it.Close()
+	it.Close() // must be safe to call twice
+
+	if it.Next() {
+		t.Fatal("Next() = true after Close(), want false")
+	}
+}
+
+func TestUserIteratorHonorsContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	it := newTestIterator(ctx)
+	cancel()
+
+	if it.Next() {
+		t.Fatal("Next() = true after context cancellation, want false")
+	}
+	if !errors.Is(it.Err(), context.Canceled) {
+		t.Fatalf("Err() = %v, want context.Canceled", it.Err())
+	}
+}
+
+func TestUserIteratorStopsOnEmptyPage(t *testing.T) {
+	it := newTestIterator(context.Background())
+	it.pages <- pageResult{users: nil}
+
+	if it.Next() {
+		t.Fatal("Next() = true on an empty page, want false")
+	}
+	if it.Err() != nil {
+		t.Fatalf("Err() = %v, want nil", it.Err())
+	}
+}
+
+// sanity-check that newTestIterator's context timeout path doesn't hang
+// the test suite if Next is ever changed to block indefinitely.
+func TestUserIteratorNextDoesNotHangWithoutData(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	it := newTestIterator(ctx)
+
+	done := make(chan bool, 1)
+	go func() { done <- it.Next() }()
+
+	select {
+	case ok := <-done:
+		if ok {
+			t.Fatal("Next() = true, want false once the context times out")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Next() did not return after context timeout")
+	}
+}
@@ -0,0 +1,370 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// DesiredUser describes the desired state of a single user for
+// UserProvisioningService.Reconcile.
+type DesiredUser struct {
+	AccountID    string
+	EmailAddress string
+	DisplayName  string
+	Active       bool
+	Groups       []string
+}
+
+// ReconcileActionType identifies what Reconcile did, or would do in
+// dry-run mode, for a single user or group membership.
+type ReconcileActionType string
+
+// The actions a Reconcile pass can take.
+const (
+	ReconcileActionCreate      ReconcileActionType = "create"
+	ReconcileActionDelete      ReconcileActionType = "delete"
+	ReconcileActionEnable      ReconcileActionType = "enable"
+	ReconcileActionDisable     ReconcileActionType = "disable"
+	ReconcileActionAddGroup    ReconcileActionType = "add-group"
+	ReconcileActionRemoveGroup ReconcileActionType = "remove-group"
+	ReconcileActionNoop        ReconcileActionType = "no-op"
+)
+
+// ReconcileAction records a single action Reconcile took (or would take,
+// in dry-run mode) for one user.
+type ReconcileAction struct {
+	AccountID string
+	Action    ReconcileActionType
+	// Group is set for ReconcileActionAddGroup / ReconcileActionRemoveGroup.
+	Group string
+	Err   error
+}
+
+// ReconcileReport summarizes the outcome of a Reconcile call: every
+// action taken, any errors encountered, and any no-ops, so callers can log
+// or emit metrics from it.
+type ReconcileReport struct {
+	DryRun  bool
+	Actions []ReconcileAction
+}
+
+// HasErrors reports whether any action in the report failed.
+func (r *ReconcileReport) HasErrors() bool {
+	for _, a := range r.Actions {
+		if a.Err != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// UserProvisioningService batches create/delete/enable/disable of users
+// and reconciles their group memberships against a declarative desired
+// state, the kind of operation SCIM-style identity syncs and audit tools
+// need.
+type UserProvisioningService struct {
+	client *Client
+
+	Users  *UserService
+	Groups *GroupService
+
+	concurrency int
+	dryRun      bool
+	maxRetries  int
+}
+
+// ProvisioningOption configures a UserProvisioningService.
+type ProvisioningOption func(*UserProvisioningService)
+
+// WithConcurrency bounds how many users Reconcile converges at once.
+func WithConcurrency(n int) ProvisioningOption {
+	return func(s *UserProvisioningService) {
+		if n > 0 {
+			s.concurrency = n
+		}
+	}
+}
+
+// WithDryRun makes Reconcile compute and report the actions it would take
+// without performing any of them.
+func WithDryRun(dryRun bool) ProvisioningOption {
+	return func(s *UserProvisioningService) {
+		s.dryRun = dryRun
+	}
+}
+
+// WithMaxRetries bounds how many times Reconcile retries a request that
+// fails with 429 or 5xx.
+func WithMaxRetries(n int) ProvisioningOption {
+	return func(s *UserProvisioningService) {
+		if n >= 0 {
+			s.maxRetries = n
+		}
+	}
+}
+
+// NewUserProvisioningService returns a UserProvisioningService backed by
+// client.
+func NewUserProvisioningService(client *Client, opts ...ProvisioningOption) *UserProvisioningService {
+	s := &UserProvisioningService{
+		client:      client,
+		Users:       &UserService{client: client},
+		Groups:      &GroupService{client: client},
+		concurrency: 1,
+		maxRetries:  5,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// Reconcile drives every user in desired towards its declarative
+// definition, and deletes any user Find turns up that is not present in
+// desired. Requests run through a worker pool bounded by WithConcurrency,
+// retrying with exponential backoff (honoring Retry-After) on 429/5xx. In
+// dry-run mode (WithDryRun), the current users and their group memberships
+// are still read, but no create, delete, update or group-membership request
+// is made; the report describes what would have happened.
+func (s *UserProvisioningService) Reconcile(ctx context.Context, desired []DesiredUser) (*ReconcileReport, error) {
+	report := &ReconcileReport{DryRun: s.dryRun}
+
+	desiredByAccountID := make(map[string]DesiredUser, len(desired))
+	for _, du := range desired {
+		desiredByAccountID[du.AccountID] = du
+	}
+
+	current, err := s.currentRoster(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sem := make(chan struct{}, s.concurrency)
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	record := func(actions ...ReconcileAction) {
+		mu.Lock()
+		report.Actions = append(report.Actions, actions...)
+		mu.Unlock()
+	}
+
+	for _, existing := range current {
+		existing := existing
+		if _, wanted := desiredByAccountID[existing.AccountID]; wanted {
+			continue
+		}
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			record(s.deleteUser(ctx, existing.AccountID))
+		}()
+	}
+
+	currentByAccountID := make(map[string]User, len(current))
+	for _, u := range current {
+		currentByAccountID[u.AccountID] = u
+	}
+
+	for _, du := range desired {
+		du := du
+		wg.Add(1)
+		sem <- struct{}{}
+		go func() {
+			defer wg.Done()
+			defer func() { <-sem }()
+			existing, ok := currentByAccountID[du.AccountID]
+			record(s.reconcileUser(ctx, du, existing, ok)...)
+		}()
+	}
+
+	wg.Wait()
+	return report, nil
+}
+
+// allUsersProperty is the username value Jira Server/DC's user/search
+// documents for matching every user ("." is treated as a wildcard by the
+// underlying query, unlike an empty username which some instances reject
+// outright). See:
+// https://confluence.atlassian.com/jirakb/unable-to-get-all-users-using-rest-api-in-jira-959812238.html
+const allUsersProperty = "."
+
+func (s *UserProvisioningService) currentRoster(ctx context.Context) ([]User, error) {
+	var users []User
+	it := s.Users.FindAll(ctx, allUsersProperty)
+	defer it.Close()
+	for it.Next() {
+		users = append(users, it.User())
+	}
+	if err := it.Err(); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (s *UserProvisioningService) reconcileUser(ctx context.Context, du DesiredUser, existing User, exists bool) []ReconcileAction {
+	var actions []ReconcileAction
+
+	if !exists {
+		created := s.createUser(ctx, du)
+		actions = append(actions, created)
+		if created.Err != nil {
+			return actions
+		}
+		// The user has no groups yet (real or, in dry-run, hypothetical),
+		// so reconcile straight from an empty set instead of reading it
+		// back from Jira.
+		actions = append(actions, s.reconcileGroupsFrom(ctx, du.AccountID, nil, du.Groups)...)
+		return actions
+	}
+
+	if existing.Active != du.Active {
+		actions = append(actions, s.setActive(ctx, du))
+	}
+
+	groupActions, err := s.reconcileGroups(ctx, du)
+	if err != nil {
+		actions = append(actions, ReconcileAction{AccountID: du.AccountID, Action: ReconcileActionNoop, Err: err})
+		return actions
+	}
+	actions = append(actions, groupActions...)
+
+	if len(actions) == 0 {
+		actions = append(actions, ReconcileAction{AccountID: du.AccountID, Action: ReconcileActionNoop})
+	}
+	return actions
+}
+
+func (s *UserProvisioningService) createUser(ctx context.Context, du DesiredUser) ReconcileAction {
+	action := ReconcileAction{AccountID: du.AccountID, Action: ReconcileActionCreate}
+	if s.dryRun {
+		return action
+	}
+
+	user := &User{
+		AccountID:    du.AccountID,
+		EmailAddress: du.EmailAddress,
+		DisplayName:  du.DisplayName,
+		Active:       du.Active,
+	}
+	_, err := withRetry(ctx, s.maxRetries, func() (*Response, error) {
+		_, resp, err := s.Users.CreateWithContext(ctx, user)
+		return resp, err
+	})
+	action.Err = err
+	return action
+}
+
+func (s *UserProvisioningService) deleteUser(ctx context.Context, accountID string) ReconcileAction {
+	action := ReconcileAction{AccountID: accountID, Action: ReconcileActionDelete}
+	if s.dryRun {
+		return action
+	}
+
+	qb := newQueryBuilder()
+	qb.Add("accountId", accountID)
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user?%s", qb.Encode())
+	_, err := withRetry(ctx, s.maxRetries, func() (*Response, error) {
+		req, err := s.client.NewRequestWithContext(ctx, "DELETE", apiEndpoint, nil)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := s.client.Do(req, nil)
+		if err != nil {
+			return resp, NewJiraError(resp, err)
+		}
+		return resp, nil
+	})
+	action.Err = err
+	return action
+}
+
+func (s *UserProvisioningService) setActive(ctx context.Context, du DesiredUser) ReconcileAction {
+	action := ReconcileAction{AccountID: du.AccountID, Action: ReconcileActionDisable}
+	if du.Active {
+		action.Action = ReconcileActionEnable
+	}
+	if s.dryRun {
+		return action
+	}
+
+	user := &User{AccountID: du.AccountID, Active: du.Active}
+	_, err := withRetry(ctx, s.maxRetries, func() (*Response, error) {
+		_, resp, err := s.Users.UpdateWithContext(ctx, user)
+		return resp, err
+	})
+	action.Err = err
+	return action
+}
+
+func (s *UserProvisioningService) reconcileGroups(ctx context.Context, du DesiredUser) ([]ReconcileAction, error) {
+	existingGroups, _, err := s.Users.GetGroupsByAccountIDWithContext(ctx, du.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	var have []string
+	if existingGroups != nil {
+		for _, g := range *existingGroups {
+			have = append(have, g.Name)
+		}
+	}
+	return s.reconcileGroupsFrom(ctx, du.AccountID, have, du.Groups), nil
+}
+
+// reconcileGroupsFrom diffs have against want and issues the add-group /
+// remove-group calls needed to converge accountID's membership, without
+// itself reading current membership from Jira.
+func (s *UserProvisioningService) reconcileGroupsFrom(ctx context.Context, accountID string, have, want []string) []ReconcileAction {
+	haveSet := make(map[string]bool, len(have))
+	for _, g := range have {
+		haveSet[g] = true
+	}
+	wantSet := make(map[string]bool, len(want))
+	for _, g := range want {
+		wantSet[g] = true
+	}
+
+	var actions []ReconcileAction
+	for group := range wantSet {
+		if haveSet[group] {
+			continue
+		}
+		actions = append(actions, s.addGroup(ctx, accountID, group))
+	}
+	for group := range haveSet {
+		if wantSet[group] {
+			continue
+		}
+		actions = append(actions, s.removeGroup(ctx, accountID, group))
+	}
+	return actions
+}
+
+func (s *UserProvisioningService) addGroup(ctx context.Context, accountID, group string) ReconcileAction {
+	action := ReconcileAction{AccountID: accountID, Action: ReconcileActionAddGroup, Group: group}
+	if s.dryRun {
+		return action
+	}
+
+	_, err := withRetry(ctx, s.maxRetries, func() (*Response, error) {
+		return s.Groups.AddUserWithContext(ctx, group, accountID)
+	})
+	action.Err = err
+	return action
+}
+
+func (s *UserProvisioningService) removeGroup(ctx context.Context, accountID, group string) ReconcileAction {
+	action := ReconcileAction{AccountID: accountID, Action: ReconcileActionRemoveGroup, Group: group}
+	if s.dryRun {
+		return action
+	}
+
+	_, err := withRetry(ctx, s.maxRetries, func() (*Response, error) {
+		return s.Groups.RemoveUserWithContext(ctx, group, accountID)
+	})
+	action.Err = err
+	return action
+}
@@ -0,0 +1,97 @@
+package jira
+
+import (
+	"context"
+	"sort"
+	"testing"
+)
+
+// currentRoster's wildcard has no integration coverage (it needs a live
+// Client/Response implementation this package snapshot doesn't carry yet);
+// this pins the documented idiom so an accidental change back to an empty
+// username doesn't silently make Reconcile see an empty roster.
+func TestAllUsersPropertyIsDocumentedWildcard(t *testing.T) {
+	if allUsersProperty != "." {
+		t.Errorf("allUsersProperty = %q, want the documented all-users wildcard %q", allUsersProperty, ".")
+	}
+}
+
+// actionsByGroup collapses a []ReconcileAction into "action:group" pairs,
+// sorted, so assertions don't depend on reconcileGroupsFrom's map-driven
+// iteration order.
+func actionsByGroup(actions []ReconcileAction) []string {
+	out := make([]string, 0, len(actions))
+	for _, a := range actions {
+		out = append(out, string(a.Action)+":"+a.Group)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func TestReconcileGroupsFrom(t *testing.T) {
+	// dryRun means addGroup/removeGroup return without touching s.Groups,
+	// so reconcileGroupsFrom's diffing logic can be tested without a
+	// live Client.
+	s := &UserProvisioningService{dryRun: true}
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		have  []string
+		want  []string
+		want2 []string
+	}{
+		{
+			name:  "add only",
+			have:  nil,
+			want:  []string{"developers", "admins"},
+			want2: []string{"add-group:admins", "add-group:developers"},
+		},
+		{
+			name:  "remove only",
+			have:  []string{"developers", "admins"},
+			want:  nil,
+			want2: []string{"remove-group:admins", "remove-group:developers"},
+		},
+		{
+			name:  "no-op when already converged",
+			have:  []string{"developers"},
+			want:  []string{"developers"},
+			want2: nil,
+		},
+		{
+			name:  "mixed add and remove",
+			have:  []string{"developers", "contractors"},
+			want:  []string{"developers", "admins"},
+			want2: []string{"add-group:admins", "remove-group:contractors"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			actions := s.reconcileGroupsFrom(ctx, "acc-1", tt.have, tt.want)
+			for _, a := range actions {
+				if a.AccountID != "acc-1" {
+					t.Errorf("AccountID = %q, want %q", a.AccountID, "acc-1")
+				}
+				if a.Err != nil {
+					t.Errorf("unexpected error in dry-run action: %v", a.Err)
+				}
+			}
+
+			got := actionsByGroup(actions)
+			want := make([]string, len(tt.want2))
+			copy(want, tt.want2)
+			sort.Strings(want)
+
+			if len(got) != len(want) {
+				t.Fatalf("actions = %v, want %v", got, want)
+			}
+			for i := range want {
+				if got[i] != want[i] {
+					t.Fatalf("actions = %v, want %v", got, want)
+				}
+			}
+		})
+	}
+}
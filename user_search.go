@@ -0,0 +1,145 @@
+package jira
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// UserPickerResult is the response of the user-picker search endpoint. The
+// matched users carry HTML-highlighted spans around the matched text, as
+// Jira's own picker UI renders them directly.
+type UserPickerResult struct {
+	Users  []UserPickerUser `json:"users,omitempty" structs:"users,omitempty"`
+	Total  int              `json:"total,omitempty" structs:"total,omitempty"`
+	Header string           `json:"header,omitempty" structs:"header,omitempty"`
+}
+
+// UserPickerUser represents a single match returned by the user-picker
+// endpoint. HTMLName is the only field Jira returns with match highlights;
+// there is no separate HTML-highlighted displayName in the response.
+type UserPickerUser struct {
+	AccountID   string `json:"accountId,omitempty" structs:"accountId,omitempty"`
+	HTMLName    string `json:"html,omitempty" structs:"html,omitempty"`
+	DisplayName string `json:"displayName,omitempty" structs:"displayName,omitempty"`
+	AvatarUrl   string `json:"avatarUrl,omitempty" structs:"avatarUrl,omitempty"`
+}
+
+// WithProject scopes a user search to the given project key.
+func WithProject(projectKey string) SearchOption {
+	return func(qb *queryBuilder) {
+		qb.Add("projectKeys", projectKey)
+	}
+}
+
+// WithIssueKey scopes a user search to the given issue key.
+func WithIssueKey(issueKey string) SearchOption {
+	return func(qb *queryBuilder) {
+		qb.Add("issueKey", issueKey)
+	}
+}
+
+// WithActionDescriptorID restricts an assignable-user search to users who
+// can perform the workflow transition identified by actionDescriptorID.
+func WithActionDescriptorID(actionDescriptorID int) SearchOption {
+	return func(qb *queryBuilder) {
+		qb.Add("actionDescriptorId", fmt.Sprintf("%d", actionDescriptorID))
+	}
+}
+
+// WithExcludeAccountIDs excludes the given account IDs from the search
+// results.
+func WithExcludeAccountIDs(accountIDs ...string) SearchOption {
+	return func(qb *queryBuilder) {
+		qb.Add("excludeAccountIds", strings.Join(accountIDs, ","))
+	}
+}
+
+// FindAssignableUsersForIssueWithContext searches for users that can be
+// assigned to the given issue.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-findAssignableUsers
+func (s *UserService) FindAssignableUsersForIssueWithContext(ctx context.Context, issueKey string, tweaks ...SearchOption) ([]User, *Response, error) {
+	qb := newQueryBuilder()
+	qb.Add("issueKey", issueKey)
+	for _, f := range tweaks {
+		f(qb)
+	}
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user/assignable/search?%s", qb.Encode())
+	return s.findUsers(ctx, apiEndpoint)
+}
+
+// FindAssignableUsersForIssue wraps FindAssignableUsersForIssueWithContext
+// using the background context.
+func (s *UserService) FindAssignableUsersForIssue(issueKey string, tweaks ...SearchOption) ([]User, *Response, error) {
+	return s.FindAssignableUsersForIssueWithContext(context.Background(), issueKey, tweaks...)
+}
+
+// FindAssignableUsersForProjectWithContext searches for users that can be
+// assigned to issues in one or more projects.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-findAssignableUsersMultiProject
+func (s *UserService) FindAssignableUsersForProjectWithContext(ctx context.Context, projectKey string, tweaks ...SearchOption) ([]User, *Response, error) {
+	qb := newQueryBuilder()
+	qb.Add("projectKeys", projectKey)
+	for _, f := range tweaks {
+		f(qb)
+	}
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user/assignable/multiProjectSearch?%s", qb.Encode())
+	return s.findUsers(ctx, apiEndpoint)
+}
+
+// FindAssignableUsersForProject wraps
+// FindAssignableUsersForProjectWithContext using the background context.
+func (s *UserService) FindAssignableUsersForProject(projectKey string, tweaks ...SearchOption) ([]User, *Response, error) {
+	return s.FindAssignableUsersForProjectWithContext(context.Background(), projectKey, tweaks...)
+}
+
+func (s *UserService) findUsers(ctx context.Context, apiEndpoint string) ([]User, *Response, error) {
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	users := []User{}
+	resp, err := s.client.Do(req, &users)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return users, resp, nil
+}
+
+// FindUsersForPickerWithContext searches for users matching query, in the
+// same HTML-highlighted format used by Jira's own @-mention and assignee
+// pickers.
+//
+// JIRA API docs: https://docs.atlassian.com/jira/REST/cloud/#api/2/user-findUsersForPicker
+func (s *UserService) FindUsersForPickerWithContext(ctx context.Context, query string, showAvatar bool, tweaks ...SearchOption) (*UserPickerResult, *Response, error) {
+	qb := newQueryBuilder()
+	qb.Add("query", query)
+	qb.Add("showAvatar", fmt.Sprintf("%t", showAvatar))
+	for _, f := range tweaks {
+		f(qb)
+	}
+
+	apiEndpoint := fmt.Sprintf("/rest/api/2/user/picker?%s", qb.Encode())
+	req, err := s.client.NewRequestWithContext(ctx, "GET", apiEndpoint, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	result := new(UserPickerResult)
+	resp, err := s.client.Do(req, result)
+	if err != nil {
+		return nil, resp, NewJiraError(resp, err)
+	}
+	return result, resp, nil
+}
+
+// FindUsersForPicker wraps FindUsersForPickerWithContext using the
+// background context.
+func (s *UserService) FindUsersForPicker(query string, showAvatar bool, tweaks ...SearchOption) (*UserPickerResult, *Response, error) {
+	return s.FindUsersForPickerWithContext(context.Background(), query, showAvatar, tweaks...)
+}
@@ -0,0 +1,56 @@
+package jira
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestAssignableSearchOptionsWriteIntoQueryBuilder(t *testing.T) {
+	qb := newQueryBuilder()
+	qb.Add("issueKey", "PROJ-1")
+	tweaks := []SearchOption{
+		WithProject("PROJ"),
+		WithActionDescriptorID(5),
+		WithExcludeAccountIDs("acc-1", "acc-2"),
+	}
+	for _, f := range tweaks {
+		f(qb)
+	}
+
+	got := qb.Encode()
+	want := "actionDescriptorId=5&excludeAccountIds=acc-1%2Cacc-2&issueKey=PROJ-1&projectKeys=PROJ"
+	if got != want {
+		t.Errorf("Encode() = %q, want %q", got, want)
+	}
+}
+
+func TestUserPickerResultUnmarshalsHTMLSpans(t *testing.T) {
+	// Matches the documented /rest/api/2/user/picker response shape:
+	// https://docs.atlassian.com/jira/REST/cloud/#api/2/user-findUsersForPicker
+	body := `{
+		"users": [
+			{"accountId": "acc-1", "html": "<b>J</b>ohn Doe", "displayName": "John Doe", "avatarUrl": "https://example.com/avatar.png"}
+		],
+		"total": 1,
+		"header": "Showing 1 of 1 matching users"
+	}`
+
+	var result UserPickerResult
+	if err := json.Unmarshal([]byte(body), &result); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+
+	if result.Total != 1 || result.Header == "" {
+		t.Fatalf("unexpected result: %+v", result)
+	}
+	if len(result.Users) != 1 {
+		t.Fatalf("got %d users, want 1", len(result.Users))
+	}
+	got := result.Users[0]
+	if got.HTMLName != "<b>J</b>ohn Doe" {
+		t.Errorf("HTMLName = %q, want the HTML-highlighted span", got.HTMLName)
+	}
+	if got.DisplayName != "John Doe" {
+		t.Errorf("DisplayName = %q, want %q", got.DisplayName, "John Doe")
+	}
+}